@@ -5,12 +5,72 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
 
+// quiet suppresses non-error output when set via --quiet
+var quiet bool
 
+// usageError marks errors caused by invalid command-line usage,
+// so that Execute can exit with status 2 instead of 1.
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string { return e.err.Error() }
+
+func (e usageError) Unwrap() error { return e.err }
+
+// usageArgs wraps a positional argument validator so that its
+// errors are reported as usage errors.
+func usageArgs(validate cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := validate(cmd, args); err != nil {
+			return usageError{err: err}
+		}
+		return nil
+	}
+}
+
+// unknownCommand rejects positional arguments to the root command as unknown
+// subcommands. The message matches cobra's built-in check, including the
+// "Did you mean this?" suggestions and the --help hint. The usage dump is
+// silenced for this invocation, since the hint already points to it.
+func unknownCommand(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	cmd.SilenceUsage = true
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "unknown command %q for %q", args[0], cmd.CommandPath())
+	if !cmd.DisableSuggestions {
+		if cmd.SuggestionsMinimumDistance <= 0 {
+			cmd.SuggestionsMinimumDistance = 2
+		}
+		if suggestions := cmd.SuggestionsFor(args[0]); len(suggestions) > 0 {
+			sb.WriteString("\n\nDid you mean this?\n")
+			for _, s := range suggestions {
+				fmt.Fprintf(&sb, "\t%v\n", s)
+			}
+		}
+	}
+	fmt.Fprintf(&sb, "\nRun '%v --help' for usage.", cmd.CommandPath())
+	return errors.New(sb.String())
+}
+
+// noArgs rejects positional arguments to a command that takes none.
+func noArgs(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("accepts no arguments, got %d", len(args))
+	}
+	return nil
+}
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -25,23 +85,49 @@ to quickly create a Cobra application.`,
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	// Run: func(cmd *cobra.Command, args []string) { },
+
+	// The bare application has no action of its own and only prints help.
+	// It is runnable so that unknownCommand can report unknown subcommands
+	// as usage errors.
+	Args: usageArgs(unknownCommand),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	err := rootCmd.Execute()
-	if err != nil {
-		os.Exit(1)
+	if code := exitCode(rootCmd.Execute()); code != 0 {
+		os.Exit(code)
 	}
 }
 
+// exitCode maps an error returned by rootCmd.Execute to a process exit status:
+// 0 on success, 2 on a usage error and 1 on any other error.
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var uerr usageError
+	if errors.As(err, &uerr) {
+		return 2
+	}
+	return 1
+}
+
 func init() {
 	// Here you will define your flags and configuration settings.
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
 
 	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.go-practice.yaml)")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Suppress non-error output")
+
+	// Flag parsing errors are usage errors; subcommands inherit this.
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return usageError{err: err}
+	})
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
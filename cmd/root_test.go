@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// execute runs rootCmd with args and returns what was written to stdout and stderr.
+func execute(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+
+	// Flag values and SilenceUsage persist on the package-level commands between runs.
+	quiet = false
+	rootCmd.SilenceUsage = false
+
+	var stdout, stderr bytes.Buffer
+	rootCmd.SetOut(&stdout)
+	rootCmd.SetErr(&stderr)
+	rootCmd.SetArgs(args)
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+	})
+
+	err := rootCmd.Execute()
+	return stdout.String(), stderr.String(), err
+}
+
+func TestServeQuiet(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"after subcommand", []string{"serve", "-q"}},
+		{"before subcommand", []string{"-q", "serve"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, _, err := execute(t, tt.args...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out != "" {
+				t.Errorf("expected no output with --quiet, got %q", out)
+			}
+		})
+	}
+}
+
+func TestServeQuietKeepsErrors(t *testing.T) {
+	_, errOut, err := execute(t, "serve", "-q", "--bogus")
+	if err == nil {
+		t.Fatal("expected an error for an unknown flag")
+	}
+	if !strings.Contains(errOut, "unknown flag: --bogus") {
+		t.Errorf("expected the error on stderr with --quiet, got %q", errOut)
+	}
+}
+
+func TestServeOutput(t *testing.T) {
+	out, _, err := execute(t, "serve")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out != "serve called\n" {
+		t.Errorf("expected %q, got %q", "serve called\n", out)
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    int
+		wantErr string
+	}{
+		{"success", []string{"serve"}, 0, ""},
+		{"bad flag", []string{"serve", "--bogus"}, 2, "unknown flag: --bogus"},
+		{"unknown command", []string{"bogus"}, 2, `unknown command "bogus" for "go-practice"`},
+		{"mistyped command", []string{"serv"}, 2, "Did you mean this?\n\tserve\n"},
+		{"stray argument", []string{"serve", "extra"}, 2, "accepts no arguments, got 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errOut, err := execute(t, tt.args...)
+			if got := exitCode(err); got != tt.want {
+				t.Errorf("exitCode(%v) = %d, want %d", err, got, tt.want)
+			}
+			if !strings.Contains(errOut, tt.wantErr) {
+				t.Errorf("expected stderr to contain %q, got %q", tt.wantErr, errOut)
+			}
+		})
+	}
+}
+
+func TestUnknownCommandHint(t *testing.T) {
+	_, errOut, _ := execute(t, "serv")
+	if !strings.HasSuffix(errOut, "Run 'go-practice --help' for usage.\n") {
+		t.Errorf("expected the --help hint at the end of stderr, got %q", errOut)
+	}
+	if strings.Contains(errOut, "Usage:") {
+		t.Errorf("expected no usage dump for an unknown command, got %q", errOut)
+	}
+}
+
+func TestExitCodeGenericError(t *testing.T) {
+	if got := exitCode(errors.New("conversion failed")); got != 1 {
+		t.Errorf("exitCode = %d, want 1", got)
+	}
+}
@@ -20,8 +20,11 @@ and usage of using your command. For example:
 Cobra is a CLI library for Go that empowers applications.
 This application is a tool to generate the needed files
 to quickly create a Cobra application.`,
+	Args: usageArgs(noArgs),
 	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("serve called")
+		if !quiet {
+			fmt.Fprintln(cmd.OutOrStdout(), "serve called")
+		}
 	},
 }
 